@@ -0,0 +1,111 @@
+package goro
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Future is a handle to a task started with GoFuture. Unlike the
+// handler-based Go* helpers, it lets the caller collect the result later by
+// calling Await, which is convenient when fanning out several tasks and
+// joining on them afterwards.
+type Future[T any] struct {
+	done   chan struct{}
+	once   sync.Once
+	result T
+	err    error
+	cancel context.CancelFunc
+}
+
+// GoFuture starts f in a goroutine using the same panic-recovery Start
+// logic as Goro, and returns a Future that can be awaited for the result
+// instead of delivering it through a ResultHandler.
+func GoFuture[T any](f TypedFunc[T]) *Future[T] {
+	ctx, cancel := context.WithCancel(context.Background())
+	fut := &Future[T]{
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+
+	NewCtx(func(ctx context.Context) (any, error) {
+		result, err := f()
+		// Box the result in a pointer so Goro's result handler always
+		// fires, even when T's zero value would otherwise look like a
+		// nil any and be skipped.
+		return &result, err
+	}).WithContext(ctx).WithErrorHandler(func(err error) {
+		var zero T
+		fut.complete(zero, err)
+	}).WithResultHandler(func(result any) {
+		fut.complete(*result.(*T), nil)
+	}).Start()
+
+	return fut
+}
+
+// complete records the outcome of the task and unblocks Await. Only the
+// first call has an effect.
+func (f *Future[T]) complete(result T, err error) {
+	f.once.Do(func() {
+		f.result = result
+		f.err = err
+		close(f.done)
+	})
+}
+
+// Await blocks until the task completes or ctx is done, whichever happens
+// first. An already-completed future always wins: a plain select between
+// f.done and ctx.Done() picks pseudo-randomly when both are ready, which
+// would let WaitAll report a successfully finished task as cancelled just
+// because the shared ctx happened to expire around the same time.
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.result, f.err
+	default:
+	}
+
+	select {
+	case <-f.done:
+		return f.result, f.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Done returns a channel that is closed once the task has completed.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Cancel cancels the context raced against the underlying task by runCtx.
+// Since TypedFunc[T] takes no context.Context, the task itself has no way
+// to observe or react to this: Cancel only unblocks a pending Await early
+// with ctx.Err(); it does not and cannot stop, interrupt, or preempt the
+// still-running goroutine.
+func (f *Future[T]) Cancel() {
+	f.cancel()
+}
+
+// WaitAll awaits every future in futures and collects their results in
+// order. If one or more futures complete with an error, WaitAll returns
+// the partial results alongside the joined errors.
+func WaitAll[T any](ctx context.Context, futures ...*Future[T]) ([]T, error) {
+	results := make([]T, len(futures))
+	var errs []error
+
+	for i, fut := range futures {
+		result, err := fut.Await(ctx)
+		results[i] = result
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return results, errors.Join(errs...)
+	}
+	return results, nil
+}
@@ -0,0 +1,171 @@
+package goro
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestGoFutureAwaitResult tests that Await returns a successful result
+func TestGoFutureAwaitResult(t *testing.T) {
+	fut := GoFuture(func() (int, error) {
+		return 42, nil
+	})
+
+	result, err := fut.Await(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result != 42 {
+		t.Errorf("Expected result 42, got %d", result)
+	}
+}
+
+// TestGoFutureAwaitError tests that Await returns the task's error
+func TestGoFutureAwaitError(t *testing.T) {
+	expectedErr := errors.New("future task failed")
+	fut := GoFuture(func() (int, error) {
+		return 0, expectedErr
+	})
+
+	_, err := fut.Await(context.Background())
+	if !errors.Is(err, expectedErr) {
+		t.Errorf("Expected error %v, got %v", expectedErr, err)
+	}
+}
+
+// TestGoFutureAwaitPanic tests that a panicking task surfaces a PanicError
+func TestGoFutureAwaitPanic(t *testing.T) {
+	fut := GoFuture(func() (int, error) {
+		panic("future panic")
+	})
+
+	_, err := fut.Await(context.Background())
+	if !IsPanic(err) {
+		t.Errorf("Expected a PanicError, got %v", err)
+	}
+}
+
+// TestGoFutureDone tests that Done is closed once the task completes
+func TestGoFutureDone(t *testing.T) {
+	fut := GoFuture(func() (int, error) {
+		return 1, nil
+	})
+
+	select {
+	case <-fut.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Expected Done to be closed after the task completes")
+	}
+}
+
+// TestGoFutureAwaitContextCancelled tests that Await returns early when the
+// passed-in context is cancelled before the task completes
+func TestGoFutureAwaitContextCancelled(t *testing.T) {
+	started := make(chan struct{})
+	fut := GoFuture(func() (int, error) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		return 1, nil
+	})
+
+	<-started
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := fut.Await(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// TestGoFutureCancel tests that Cancel propagates to the task's context
+func TestGoFutureCancel(t *testing.T) {
+	started := make(chan struct{})
+	fut := GoFuture(func() (int, error) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		return 1, nil
+	})
+
+	<-started
+	fut.Cancel()
+
+	result, err := fut.Await(context.Background())
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if result != 0 {
+		t.Errorf("Expected zero value result, got %d", result)
+	}
+}
+
+// TestGoFutureAwaitPrefersCompletionOverSimultaneousDeadline tests that
+// Await reports a future's real result rather than ctx.Err() when both
+// f.done and ctx.Done() are already ready by the time Await is called, so a
+// finished task isn't misreported as cancelled by an unlucky select pick.
+func TestGoFutureAwaitPrefersCompletionOverSimultaneousDeadline(t *testing.T) {
+	fut := GoFuture(func() (int, error) {
+		return 7, nil
+	})
+
+	// Give the task a moment to finish, then use an already-expired
+	// context so both channels are ready before Await's select runs.
+	<-fut.Done()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for i := 0; i < 100; i++ {
+		result, err := fut.Await(ctx)
+		if err != nil {
+			t.Fatalf("Expected the completed result, got error %v", err)
+		}
+		if result != 7 {
+			t.Errorf("Expected result 7, got %d", result)
+		}
+	}
+}
+
+// TestWaitAll tests that WaitAll collects results from multiple futures in
+// order
+func TestWaitAll(t *testing.T) {
+	futures := make([]*Future[int], 3)
+	for i := range futures {
+		i := i
+		futures[i] = GoFuture(func() (int, error) {
+			return i, nil
+		})
+	}
+
+	results, err := WaitAll(context.Background(), futures...)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for i, result := range results {
+		if result != i {
+			t.Errorf("Expected results[%d] = %d, got %d", i, i, result)
+		}
+	}
+}
+
+// TestWaitAllJoinsErrors tests that WaitAll joins errors from all failing
+// futures
+func TestWaitAllJoinsErrors(t *testing.T) {
+	err1 := errors.New("first failure")
+	err2 := errors.New("second failure")
+
+	futures := []*Future[int]{
+		GoFuture(func() (int, error) { return 0, err1 }),
+		GoFuture(func() (int, error) { return 1, nil }),
+		GoFuture(func() (int, error) { return 0, err2 }),
+	}
+
+	_, err := WaitAll(context.Background(), futures...)
+	if !errors.Is(err, err1) {
+		t.Errorf("Expected joined error to contain %v", err1)
+	}
+	if !errors.Is(err, err2) {
+		t.Errorf("Expected joined error to contain %v", err2)
+	}
+}
@@ -3,8 +3,8 @@
 package goro
 
 import (
+	"context"
 	"fmt"
-	"runtime/debug"
 )
 
 // Func is a function type that can be executed in a goroutine.
@@ -12,6 +12,13 @@ import (
 // If there is no result to return, nil should be returned.
 type Func func() (any, error)
 
+// FuncCtx is a function type that can be executed in a goroutine with
+// access to a context.Context, allowing it to observe cancellation and
+// deadlines. It returns a result of any type and an error if the
+// execution fails. If there is no result to return, nil should be
+// returned.
+type FuncCtx func(context.Context) (any, error)
+
 // ErrorHandler is a function type that handles errors.
 type ErrorHandler func(error)
 
@@ -26,8 +33,11 @@ func defaultErrorHandler(err error) {
 // Goro represents a goroutine execution with error and result handling.
 type Goro struct {
 	fn            Func
+	ctxFn         FuncCtx
+	ctx           context.Context
 	errHandler    ErrorHandler
 	resultHandler ResultHandler
+	retry         *RetryPolicy
 }
 
 // New creates a new Goro instance with the provided function.
@@ -38,6 +48,25 @@ func New(f Func) *Goro {
 	}
 }
 
+// NewCtx creates a new Goro instance wrapping a context-aware function. The
+// context defaults to context.Background() until WithContext is used to
+// supply one with cancellation or a deadline.
+func NewCtx(f FuncCtx) *Goro {
+	return &Goro{
+		ctxFn:      f,
+		ctx:        context.Background(),
+		errHandler: defaultErrorHandler,
+	}
+}
+
+// WithContext attaches ctx to the Goro instance. It is only meaningful for
+// Goro values created with NewCtx; the context is passed to the underlying
+// FuncCtx and is watched for cancellation while the task is running.
+func (g *Goro) WithContext(ctx context.Context) *Goro {
+	g.ctx = ctx
+	return g
+}
+
 // WithErrorHandler sets a custom error handler for the Goro instance.
 func (g *Goro) WithErrorHandler(handler ErrorHandler) *Goro {
 	g.errHandler = handler
@@ -52,25 +81,115 @@ func (g *Goro) WithResultHandler(handler ResultHandler) *Goro {
 
 // Start executes the function in a goroutine with the configured handlers.
 func (g *Goro) Start() {
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				err := fmt.Errorf("panic recovered: %v\nStack trace: %s", r, debug.Stack())
-				g.errHandler(err)
-			}
-		}()
+	go g.run()
+}
+
+// run performs the actual panic-recovered execution of the configured
+// function. It is split out from Start so other callers (such as a worker
+// pool) can reuse the same recovery machinery without spawning an extra
+// goroutine.
+func (g *Goro) run() {
+	normalReturn := false
+	defer func() {
+		if normalReturn {
+			return
+		}
+		if r := recover(); r != nil {
+			g.handlePanic(newPanicError(r))
+			return
+		}
+		// recover() returned nil but run() did not return normally: the
+		// function must have exited via runtime.Goexit.
+		g.handlePanic(newPanicError(ErrGoexit))
+	}()
 
-		result, err := g.fn()
+	if g.retry != nil {
+		result, err := g.runRetry()
+		normalReturn = true
 		if err != nil {
+			if pe, ok := err.(*PanicError); ok {
+				g.handlePanic(pe)
+				return
+			}
 			g.errHandler(err)
 			return
 		}
-
-		// Only call the result handler if it's set and there's a non-nil result
 		if g.resultHandler != nil && result != nil {
 			g.resultHandler(result)
 		}
+		return
+	}
+
+	if g.ctxFn != nil {
+		g.runCtx()
+		normalReturn = true
+		return
+	}
+
+	result, err := g.fn()
+	normalReturn = true
+	if err != nil {
+		g.errHandler(err)
+		return
+	}
+
+	// Only call the result handler if it's set and there's a non-nil result
+	if g.resultHandler != nil && result != nil {
+		g.resultHandler(result)
+	}
+}
+
+// runCtx executes ctxFn in its own goroutine so that cancellation of ctx can
+// be observed even while ctxFn is still running. If ctx is cancelled before
+// ctxFn returns, the error handler receives ctx.Err(); otherwise ctxFn's own
+// result or error is delivered as usual.
+func (g *Goro) runCtx() {
+	type outcome struct {
+		result any
+		err    error
+	}
+	done := make(chan outcome, 1)
+
+	go func() {
+		normalReturn := false
+		defer func() {
+			if normalReturn {
+				return
+			}
+			if r := recover(); r != nil {
+				done <- outcome{err: newPanicError(r)}
+				return
+			}
+			done <- outcome{err: newPanicError(ErrGoexit)}
+		}()
+		result, err := g.ctxFn(g.ctx)
+		normalReturn = true
+		done <- outcome{result: result, err: err}
 	}()
+
+	select {
+	case <-g.ctx.Done():
+		g.errHandler(g.ctx.Err())
+	case o := <-done:
+		if o.err != nil {
+			if pe, ok := o.err.(*PanicError); ok {
+				g.handlePanic(pe)
+				return
+			}
+			g.errHandler(o.err)
+			return
+		}
+		if g.resultHandler != nil && o.result != nil {
+			g.resultHandler(o.result)
+		}
+	}
+}
+
+// handlePanic delivers a recovered panic to this Goro's error handler and
+// then applies the package-wide panic policy (see SetPanicPolicy).
+func (g *Goro) handlePanic(pe *PanicError) {
+	g.errHandler(pe)
+	dispatchPanic(pe)
 }
 
 // Go executes the provided function in a goroutine and handles any panics.
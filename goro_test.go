@@ -1,10 +1,12 @@
 package goro
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 )
 
 // TestNew tests the New function
@@ -361,6 +363,91 @@ func TestGoWithResultHandlerError(t *testing.T) {
 	}
 }
 
+// TestNewCtx tests the NewCtx function
+func TestNewCtx(t *testing.T) {
+	f := func(ctx context.Context) (any, error) { return nil, nil }
+	g := NewCtx(f)
+
+	if g == nil {
+		t.Fatal("NewCtx returned nil")
+	}
+	if g.ctxFn == nil {
+		t.Error("NewCtx did not set ctxFn")
+	}
+	if g.ctx == nil {
+		t.Error("NewCtx did not set a default context")
+	}
+}
+
+// TestWithContext tests that WithContext overrides the default context
+func TestWithContext(t *testing.T) {
+	f := func(ctx context.Context) (any, error) { return nil, nil }
+	ctx := context.Background()
+	g := NewCtx(f).WithContext(ctx)
+
+	if g.ctx != ctx {
+		t.Error("WithContext did not set the provided context")
+	}
+}
+
+// TestNewCtxStartWithResult tests that a FuncCtx result reaches the result handler
+func TestNewCtxStartWithResult(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	expectedResult := "ctx result"
+	var receivedResult any
+
+	resultHandler := func(result any) {
+		receivedResult = result
+		wg.Done()
+	}
+
+	f := func(ctx context.Context) (any, error) {
+		return expectedResult, nil
+	}
+
+	NewCtx(f).WithResultHandler(resultHandler).Start()
+
+	wg.Wait()
+
+	if receivedResult != expectedResult {
+		t.Errorf("Expected result %v, got %v", expectedResult, receivedResult)
+	}
+}
+
+// TestNewCtxCancelledBeforeReturn tests that a cancelled context surfaces
+// ctx.Err() to the error handler even while the function is still running
+func TestNewCtxCancelledBeforeReturn(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var receivedErr error
+
+	errHandler := func(err error) {
+		receivedErr = err
+		wg.Done()
+	}
+
+	started := make(chan struct{})
+	f := func(ctx context.Context) (any, error) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		return nil, nil
+	}
+
+	NewCtx(f).WithContext(ctx).WithErrorHandler(errHandler).Start()
+
+	<-started
+	cancel()
+	wg.Wait()
+
+	if !errors.Is(receivedErr, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", receivedErr)
+	}
+}
+
 // TestDefaultErrorHandler tests the default error handler
 func TestDefaultErrorHandler(t *testing.T) {
 	// This test is mainly for coverage, as the default handler just prints to stdout
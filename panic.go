@@ -0,0 +1,72 @@
+package goro
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// ErrGoexit is the panic value used to wrap a goroutine that terminated via
+// runtime.Goexit instead of returning normally or panicking. Without this,
+// a call to runtime.Goexit (for example from testing.T.FailNow on a
+// goroutine-local *testing.T) would be silently swallowed by recover.
+var ErrGoexit = errors.New("goro: goroutine exited via runtime.Goexit")
+
+// PanicError is the error delivered to an ErrorHandler when a task panics.
+// It keeps the raw panic value, the captured stack trace, and the id of
+// the goroutine that panicked separate from each other, instead of folding
+// them into a single formatted string, so callers can inspect or compare
+// them programmatically.
+type PanicError struct {
+	// Value is the value passed to panic. If the panicking code panicked
+	// with an error, Value holds that error and is also returned by
+	// Unwrap.
+	Value any
+	// Stack is the stack trace captured at the point of recovery.
+	Stack []byte
+	// Goroutine is the id of the goroutine that panicked, parsed from
+	// Stack. It is 0 if the id could not be determined.
+	Goroutine uint64
+}
+
+// Error implements the error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic recovered: %v\nStack trace: %s", e.Value, e.Stack)
+}
+
+// Unwrap returns the panic value as an error when it is one, so that
+// errors.Is and errors.As can see through the panic to the underlying
+// error. It returns nil when the panic value is not itself an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// IsPanic reports whether err (or any error it wraps) is a *PanicError,
+// i.e. whether it originated from a recovered panic rather than a value
+// returned by the task itself.
+func IsPanic(err error) bool {
+	var pe *PanicError
+	return errors.As(err, &pe)
+}
+
+// newPanicError builds a PanicError from a recovered panic value, capturing
+// the current stack trace and goroutine id.
+func newPanicError(value any) *PanicError {
+	stack := debug.Stack()
+	return &PanicError{
+		Value:     value,
+		Stack:     stack,
+		Goroutine: goroutineID(stack),
+	}
+}
+
+// goroutineID parses the goroutine id out of the "goroutine N [state]:"
+// header that runtime/debug.Stack prepends to every stack trace.
+func goroutineID(stack []byte) uint64 {
+	var id uint64
+	fmt.Sscanf(string(stack), "goroutine %d ", &id)
+	return id
+}
@@ -0,0 +1,167 @@
+package goro
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestPanicErrorCarriesValueAndStack tests that a recovered panic is
+// delivered as a *PanicError with the original value and a non-empty stack
+func TestPanicErrorCarriesValueAndStack(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	var receivedErr error
+	errHandler := func(err error) {
+		receivedErr = err
+		wg.Done()
+	}
+
+	New(func() (any, error) {
+		panic("boom")
+	}).WithErrorHandler(errHandler).Start()
+
+	wg.Wait()
+
+	var pe *PanicError
+	if !errors.As(receivedErr, &pe) {
+		t.Fatalf("Expected *PanicError, got %T: %v", receivedErr, receivedErr)
+	}
+	if pe.Value != "boom" {
+		t.Errorf("Expected panic value %q, got %v", "boom", pe.Value)
+	}
+	if len(pe.Stack) == 0 {
+		t.Error("Expected a non-empty stack trace")
+	}
+	if !IsPanic(receivedErr) {
+		t.Error("Expected IsPanic to report true for a recovered panic")
+	}
+}
+
+// TestPanicErrorUnwrapsErrorValue tests that panicking with an error lets
+// errors.Is/errors.As see through to that error
+func TestPanicErrorUnwrapsErrorValue(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	sentinel := errors.New("sentinel")
+	var receivedErr error
+	errHandler := func(err error) {
+		receivedErr = err
+		wg.Done()
+	}
+
+	New(func() (any, error) {
+		panic(sentinel)
+	}).WithErrorHandler(errHandler).Start()
+
+	wg.Wait()
+
+	if !errors.Is(receivedErr, sentinel) {
+		t.Errorf("Expected errors.Is to find sentinel in %v", receivedErr)
+	}
+}
+
+// TestIsPanicFalseForRegularError tests that IsPanic does not mistake a
+// plain returned error for a recovered panic
+func TestIsPanicFalseForRegularError(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	expectedErr := errors.New("plain error")
+	var receivedErr error
+	errHandler := func(err error) {
+		receivedErr = err
+		wg.Done()
+	}
+
+	New(func() (any, error) {
+		return nil, expectedErr
+	}).WithErrorHandler(errHandler).Start()
+
+	wg.Wait()
+
+	if IsPanic(receivedErr) {
+		t.Error("Expected IsPanic to report false for a regular returned error")
+	}
+}
+
+// TestGoexitInRun tests that a plain Func exiting via runtime.Goexit is
+// wrapped as a *PanicError carrying ErrGoexit, instead of being silently
+// swallowed by run()'s recover().
+func TestGoexitInRun(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	var receivedErr error
+	errHandler := func(err error) {
+		receivedErr = err
+		wg.Done()
+	}
+
+	New(func() (any, error) {
+		runtime.Goexit()
+		return nil, nil
+	}).WithErrorHandler(errHandler).Start()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the error handler to be called instead of hanging")
+	}
+
+	if !errors.Is(receivedErr, ErrGoexit) {
+		t.Errorf("Expected ErrGoexit, got %v", receivedErr)
+	}
+	if !IsPanic(receivedErr) {
+		t.Error("Expected a *PanicError wrapping ErrGoexit")
+	}
+}
+
+// TestGoexitInRunCtx tests that a FuncCtx exiting via runtime.Goexit is
+// wrapped as a *PanicError carrying ErrGoexit, instead of leaving runCtx's
+// done channel empty and the goroutine hanging forever.
+func TestGoexitInRunCtx(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	var receivedErr error
+	errHandler := func(err error) {
+		receivedErr = err
+		wg.Done()
+	}
+
+	NewCtx(func(ctx context.Context) (any, error) {
+		runtime.Goexit()
+		return nil, nil
+	}).WithErrorHandler(errHandler).Start()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the error handler to be called instead of hanging")
+	}
+
+	if !errors.Is(receivedErr, ErrGoexit) {
+		t.Errorf("Expected ErrGoexit, got %v", receivedErr)
+	}
+	if !IsPanic(receivedErr) {
+		t.Error("Expected a *PanicError wrapping ErrGoexit")
+	}
+}
@@ -0,0 +1,86 @@
+package goro
+
+import "sync"
+
+// PanicPolicy controls what happens to a panic after it has been recovered
+// and delivered to the relevant ErrorHandler.
+type PanicPolicy int
+
+const (
+	// PanicRecover is the default policy: the panic is recovered and
+	// handed to the error handler, and nothing further happens.
+	PanicRecover PanicPolicy = iota
+	// PanicRethrowInMain sends the panic's *PanicError to the channel
+	// returned by Panics, so a user-registered goroutine (typically in
+	// main) can drain it and exit the process non-zero.
+	PanicRethrowInMain
+	// PanicCrash re-panics with the *PanicError after the error handler
+	// has run, crashing the process instead of silently continuing.
+	PanicCrash
+)
+
+var (
+	globalMu           sync.Mutex
+	globalErrorHandler ErrorHandler
+	panicPolicy        = PanicRecover
+	panicCh            = make(chan *PanicError, 16)
+)
+
+// SetGlobalErrorHandler registers handler to be called, in addition to any
+// per-Goro or per-Pool error handler, whenever a task panics. Calling it
+// more than once composes handlers rather than replacing the previous one,
+// so a library that sets a handler during init does not lose a handler an
+// application registered earlier.
+func SetGlobalErrorHandler(handler ErrorHandler) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+
+	if globalErrorHandler == nil {
+		globalErrorHandler = handler
+		return
+	}
+	prev := globalErrorHandler
+	globalErrorHandler = func(err error) {
+		prev(err)
+		handler(err)
+	}
+}
+
+// SetPanicPolicy sets the package-wide policy applied after a panic has
+// been recovered and handled. The default policy is PanicRecover.
+func SetPanicPolicy(policy PanicPolicy) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	panicPolicy = policy
+}
+
+// Panics returns the channel PanicRethrowInMain sends recovered panics to.
+// A user-registered goroutine should drain it, typically to log the panic
+// and exit the process non-zero.
+func Panics() <-chan *PanicError {
+	return panicCh
+}
+
+// dispatchPanic runs the global error handler and applies the current
+// PanicPolicy for a recovered panic. It is called in addition to, never
+// instead of, the task's own ErrorHandler.
+func dispatchPanic(pe *PanicError) {
+	globalMu.Lock()
+	handler := globalErrorHandler
+	policy := panicPolicy
+	globalMu.Unlock()
+
+	if handler != nil {
+		handler(pe)
+	}
+
+	switch policy {
+	case PanicRethrowInMain:
+		select {
+		case panicCh <- pe:
+		default:
+		}
+	case PanicCrash:
+		panic(pe)
+	}
+}
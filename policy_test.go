@@ -0,0 +1,75 @@
+package goro
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// resetPolicy restores package-level panic policy state so tests don't leak
+// into each other.
+func resetPolicy(t *testing.T) {
+	t.Cleanup(func() {
+		globalMu.Lock()
+		globalErrorHandler = nil
+		panicPolicy = PanicRecover
+		globalMu.Unlock()
+		for len(panicCh) > 0 {
+			<-panicCh
+		}
+	})
+}
+
+// TestSetGlobalErrorHandlerComposes tests that registering a second global
+// handler runs both instead of replacing the first
+func TestSetGlobalErrorHandlerComposes(t *testing.T) {
+	resetPolicy(t)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	var firstCalled, secondCalled bool
+	SetGlobalErrorHandler(func(err error) {
+		firstCalled = true
+		wg.Done()
+	})
+	SetGlobalErrorHandler(func(err error) {
+		secondCalled = true
+		wg.Done()
+	})
+
+	New(func() (any, error) {
+		panic("composed handlers")
+	}).Start()
+
+	wg.Wait()
+
+	if !firstCalled || !secondCalled {
+		t.Errorf("Expected both global handlers to be called, got first=%v second=%v", firstCalled, secondCalled)
+	}
+}
+
+// TestPanicRethrowInMain tests that PanicRethrowInMain delivers the panic
+// on the Panics channel. It asserts by blocking on Panics() itself rather
+// than synchronizing through the per-task ErrorHandler: handlePanic calls
+// the ErrorHandler and dispatchPanic as two separate, sequential steps, so
+// a handler-driven wg.Done() does not happen-after the send on panicCh and
+// asserting with a non-blocking "default" races against it.
+func TestPanicRethrowInMain(t *testing.T) {
+	resetPolicy(t)
+
+	SetPanicPolicy(PanicRethrowInMain)
+
+	New(func() (any, error) {
+		panic("rethrow me")
+	}).Start()
+
+	select {
+	case pe := <-Panics():
+		if pe.Value != "rethrow me" {
+			t.Errorf("Expected panic value %q, got %v", "rethrow me", pe.Value)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected a panic to be delivered on the Panics channel")
+	}
+}
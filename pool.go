@@ -0,0 +1,127 @@
+package goro
+
+import (
+	"context"
+	"sync"
+)
+
+// poolTask is a unit of work queued to a Pool. Exactly one of fn or ctxFn is
+// set, mirroring the Func/FuncCtx split on Goro.
+type poolTask struct {
+	fn    Func
+	ctxFn FuncCtx
+}
+
+// Pool is a bounded worker pool built on top of Goro. It runs a fixed
+// number of long-lived workers that consume queued Func/FuncCtx tasks,
+// recovering panics per task so a single bad task cannot take down a
+// worker.
+type Pool struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	tasks         chan poolTask
+	wg            sync.WaitGroup
+	errHandler    ErrorHandler
+	resultHandler ResultHandler
+}
+
+// NewPool creates a Pool with size long-lived workers. The pool's internal
+// context is derived from ctx, so cancelling ctx has the same effect as
+// calling Stop: workers stop accepting new tasks and FuncCtx tasks already
+// running can observe the cancellation.
+func NewPool(ctx context.Context, size int) *Pool {
+	pctx, cancel := context.WithCancel(ctx)
+	p := &Pool{
+		ctx:        pctx,
+		cancel:     cancel,
+		tasks:      make(chan poolTask),
+		errHandler: defaultErrorHandler,
+	}
+
+	for i := 0; i < size; i++ {
+		go p.worker()
+	}
+
+	return p
+}
+
+// WithErrorHandler sets the pool-level error handler invoked for tasks that
+// return an error or panic.
+func (p *Pool) WithErrorHandler(handler ErrorHandler) *Pool {
+	p.errHandler = handler
+	return p
+}
+
+// WithResultHandler sets the pool-level result handler invoked for tasks
+// that complete successfully with a non-nil result.
+func (p *Pool) WithResultHandler(handler ResultHandler) *Pool {
+	p.resultHandler = handler
+	return p
+}
+
+// Go enqueues f to be run by the next available worker.
+func (p *Pool) Go(f Func) {
+	p.wg.Add(1)
+	select {
+	case p.tasks <- poolTask{fn: f}:
+	case <-p.ctx.Done():
+		p.wg.Done()
+	}
+}
+
+// GoCtx enqueues a context-aware task. It receives the pool's derived
+// context, which is cancelled when Stop is called or the context passed to
+// NewPool is cancelled.
+func (p *Pool) GoCtx(f FuncCtx) {
+	p.wg.Add(1)
+	select {
+	case p.tasks <- poolTask{ctxFn: f}:
+	case <-p.ctx.Done():
+		p.wg.Done()
+	}
+}
+
+// Wait blocks until all enqueued tasks have completed.
+func (p *Pool) Wait() {
+	p.wg.Wait()
+}
+
+// Stop cancels the pool's derived context and waits for in-flight tasks to
+// drain. After Stop returns, Go and GoCtx no longer deliver work to
+// workers.
+func (p *Pool) Stop() {
+	p.cancel()
+	p.wg.Wait()
+}
+
+// worker consumes tasks from the queue until the pool's context is
+// cancelled.
+func (p *Pool) worker() {
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case t := <-p.tasks:
+			p.run(t)
+		}
+	}
+}
+
+// run executes a single task using the same panic-recovery machinery as
+// Goro, so a panicking task is recovered and routed through the pool's
+// handlers without killing the worker.
+func (p *Pool) run(t poolTask) {
+	defer p.wg.Done()
+
+	g := &Goro{
+		errHandler:    p.errHandler,
+		resultHandler: p.resultHandler,
+	}
+	if t.ctxFn != nil {
+		g.ctxFn = t.ctxFn
+		g.ctx = p.ctx
+	} else {
+		g.fn = t.fn
+	}
+	g.run()
+}
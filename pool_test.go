@@ -0,0 +1,167 @@
+package goro
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestNewPool tests that NewPool spawns workers that process queued tasks
+func TestNewPool(t *testing.T) {
+	pool := NewPool(context.Background(), 2)
+	defer pool.Stop()
+
+	var counter int64
+	for i := 0; i < 10; i++ {
+		pool.Go(func() (any, error) {
+			atomic.AddInt64(&counter, 1)
+			return nil, nil
+		})
+	}
+
+	pool.Wait()
+
+	if got := atomic.LoadInt64(&counter); got != 10 {
+		t.Errorf("Expected 10 tasks to run, got %d", got)
+	}
+}
+
+// TestPoolWithErrorHandler tests that pool-level error handling fires for
+// failing tasks
+func TestPoolWithErrorHandler(t *testing.T) {
+	pool := NewPool(context.Background(), 1)
+	defer pool.Stop()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	expectedErr := errors.New("task failed")
+	var receivedErr error
+
+	pool = pool.WithErrorHandler(func(err error) {
+		receivedErr = err
+		wg.Done()
+	})
+
+	pool.Go(func() (any, error) {
+		return nil, expectedErr
+	})
+
+	wg.Wait()
+
+	if !errors.Is(receivedErr, expectedErr) {
+		t.Errorf("Expected error %v, got %v", expectedErr, receivedErr)
+	}
+}
+
+// TestPoolWithResultHandler tests that pool-level result handling fires for
+// successful tasks
+func TestPoolWithResultHandler(t *testing.T) {
+	pool := NewPool(context.Background(), 1)
+	defer pool.Stop()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	expectedResult := "pool result"
+	var receivedResult any
+
+	pool = pool.WithResultHandler(func(result any) {
+		receivedResult = result
+		wg.Done()
+	})
+
+	pool.Go(func() (any, error) {
+		return expectedResult, nil
+	})
+
+	wg.Wait()
+
+	if receivedResult != expectedResult {
+		t.Errorf("Expected result %v, got %v", expectedResult, receivedResult)
+	}
+}
+
+// TestPoolPanicRecoveryContinuesServing tests that a panicking task is
+// recovered and the worker keeps processing subsequent tasks
+func TestPoolPanicRecoveryContinuesServing(t *testing.T) {
+	pool := NewPool(context.Background(), 1)
+	defer pool.Stop()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	var receivedErr error
+	pool = pool.WithErrorHandler(func(err error) {
+		receivedErr = err
+		wg.Done()
+	})
+
+	pool.Go(func() (any, error) {
+		panic("pool task panic")
+	})
+
+	recovered := false
+	pool.Go(func() (any, error) {
+		recovered = true
+		wg.Done()
+		return nil, nil
+	})
+
+	wg.Wait()
+
+	if receivedErr == nil {
+		t.Error("Expected error handler to be called for panicking task")
+	}
+	if !recovered {
+		t.Error("Expected worker to keep serving tasks after a panic")
+	}
+}
+
+// TestPoolGoCtx tests that GoCtx tasks receive the pool's derived context
+func TestPoolGoCtx(t *testing.T) {
+	pool := NewPool(context.Background(), 1)
+	defer pool.Stop()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	var sawCtx context.Context
+	pool.GoCtx(func(ctx context.Context) (any, error) {
+		sawCtx = ctx
+		wg.Done()
+		return nil, nil
+	})
+
+	wg.Wait()
+
+	if sawCtx == nil {
+		t.Error("Expected GoCtx task to receive a context")
+	}
+}
+
+// TestPoolStop tests that Stop cancels the pool's context and drains
+// in-flight work
+func TestPoolStop(t *testing.T) {
+	pool := NewPool(context.Background(), 1)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	pool.Go(func() (any, error) {
+		defer wg.Done()
+		time.Sleep(50 * time.Millisecond)
+		return nil, nil
+	})
+
+	pool.Stop()
+	wg.Wait()
+
+	select {
+	case <-pool.ctx.Done():
+	default:
+		t.Error("Expected pool context to be cancelled after Stop")
+	}
+}
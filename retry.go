@@ -0,0 +1,154 @@
+package goro
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy configures the exponential backoff applied by (*Goro).WithRetry.
+type RetryPolicy struct {
+	// MaxAttempts caps the total number of attempts, including the first.
+	// Zero means unlimited (subject to MaxElapsedTime).
+	MaxAttempts int
+	// InitialInterval is the backoff before the second attempt.
+	InitialInterval time.Duration
+	// MaxInterval caps the backoff computed for any single attempt. Zero
+	// means uncapped.
+	MaxInterval time.Duration
+	// MaxElapsedTime caps the total time spent waiting between attempts.
+	// Zero means unlimited (subject to MaxAttempts).
+	MaxElapsedTime time.Duration
+	// Multiplier is applied to the previous interval to compute the next
+	// one.
+	Multiplier float64
+	// RandomizationFactor adds uniform jitter in
+	// [interval*(1-RandomizationFactor), interval*(1+RandomizationFactor)].
+	RandomizationFactor float64
+	// RetryIf decides whether a given error should be retried. If nil,
+	// every error is retried.
+	RetryIf func(error) bool
+}
+
+// WithRetry wraps the Goro's task so that a failing attempt (a returned
+// error or a recovered panic) is retried with exponential backoff before
+// being surfaced to the error handler. Only the final attempt's error is
+// delivered; intermediate failures are silent unless RetryIf says
+// otherwise.
+func (g *Goro) WithRetry(policy RetryPolicy) *Goro {
+	g.retry = &policy
+	return g
+}
+
+// invokeOnce runs the configured task exactly once, recovering a panic into
+// a *PanicError so WithRetry can treat it the same as a returned error. For
+// a context-aware task, it races the attempt against ctx.Done() the same
+// way runCtx does, so cancellation in the middle of an attempt is reported
+// immediately instead of only being checked between retries.
+func (g *Goro) invokeOnce() (result any, err error) {
+	if g.ctxFn == nil {
+		defer func() {
+			if r := recover(); r != nil {
+				err = newPanicError(r)
+			}
+		}()
+		return g.fn()
+	}
+
+	type outcome struct {
+		result any
+		err    error
+	}
+	done := make(chan outcome, 1)
+
+	go func() {
+		normalReturn := false
+		defer func() {
+			if normalReturn {
+				return
+			}
+			if r := recover(); r != nil {
+				done <- outcome{err: newPanicError(r)}
+				return
+			}
+			// recover() returned nil but the goroutine did not return
+			// normally: it must have exited via runtime.Goexit.
+			done <- outcome{err: newPanicError(ErrGoexit)}
+		}()
+		result, err := g.ctxFn(g.ctx)
+		normalReturn = true
+		done <- outcome{result: result, err: err}
+	}()
+
+	select {
+	case <-g.ctx.Done():
+		return nil, g.ctx.Err()
+	case o := <-done:
+		return o.result, o.err
+	}
+}
+
+// runRetry drives invokeOnce through g.retry's backoff schedule, stopping
+// early on success, an exhausted budget, or (when the task is context-aware)
+// context cancellation.
+func (g *Goro) runRetry() (any, error) {
+	policy := *g.retry
+	retryIf := policy.RetryIf
+	if retryIf == nil {
+		retryIf = func(error) bool { return true }
+	}
+
+	var elapsed time.Duration
+	attempt := 0
+	for {
+		attempt++
+		result, err := g.invokeOnce()
+		if err == nil {
+			return result, nil
+		}
+		if g.ctx != nil && g.ctx.Err() != nil {
+			// The context was cancelled during (or before) this attempt;
+			// don't spend a retry on it.
+			return nil, g.ctx.Err()
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			return nil, err
+		}
+		if !retryIf(err) {
+			return nil, err
+		}
+
+		wait := backoffInterval(policy, attempt)
+		if policy.MaxElapsedTime > 0 && elapsed+wait > policy.MaxElapsedTime {
+			return nil, err
+		}
+
+		if g.ctx != nil {
+			select {
+			case <-g.ctx.Done():
+				return nil, g.ctx.Err()
+			case <-time.After(wait):
+			}
+		} else {
+			time.Sleep(wait)
+		}
+		elapsed += wait
+	}
+}
+
+// backoffInterval computes the jittered exponential backoff for the given
+// attempt number (1-indexed), following
+// interval = min(MaxInterval, InitialInterval * Multiplier^(attempt-1)).
+func backoffInterval(policy RetryPolicy, attempt int) time.Duration {
+	interval := float64(policy.InitialInterval) * math.Pow(policy.Multiplier, float64(attempt-1))
+	if policy.MaxInterval > 0 && interval > float64(policy.MaxInterval) {
+		interval = float64(policy.MaxInterval)
+	}
+	if policy.RandomizationFactor > 0 {
+		delta := interval * policy.RandomizationFactor
+		low := interval - delta
+		high := interval + delta
+		interval = low + rand.Float64()*(high-low)
+	}
+	return time.Duration(interval)
+}
@@ -0,0 +1,237 @@
+package goro
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestWithRetrySucceedsAfterFailures tests that a task retried under
+// WithRetry eventually reaches the result handler once it stops failing
+func TestWithRetrySucceedsAfterFailures(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	var attempts int32
+	var receivedResult any
+
+	f := func() (any, error) {
+		n := atomic.AddInt32(&attempts, 1)
+		if n < 3 {
+			return nil, errors.New("not yet")
+		}
+		return "done", nil
+	}
+
+	New(f).WithRetry(RetryPolicy{
+		MaxAttempts:     5,
+		InitialInterval: time.Millisecond,
+		Multiplier:      2,
+	}).WithResultHandler(func(result any) {
+		receivedResult = result
+		wg.Done()
+	}).Start()
+
+	wg.Wait()
+
+	if atomic.LoadInt32(&attempts) != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+	if receivedResult != "done" {
+		t.Errorf("Expected result %q, got %v", "done", receivedResult)
+	}
+}
+
+// TestWithRetryExhaustsMaxAttempts tests that the error handler only sees
+// the final error once MaxAttempts is reached
+func TestWithRetryExhaustsMaxAttempts(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	var attempts int32
+	expectedErr := errors.New("always fails")
+
+	f := func() (any, error) {
+		atomic.AddInt32(&attempts, 1)
+		return nil, expectedErr
+	}
+
+	var receivedErr error
+	New(f).WithRetry(RetryPolicy{
+		MaxAttempts:     3,
+		InitialInterval: time.Millisecond,
+		Multiplier:      2,
+	}).WithErrorHandler(func(err error) {
+		receivedErr = err
+		wg.Done()
+	}).Start()
+
+	wg.Wait()
+
+	if atomic.LoadInt32(&attempts) != 3 {
+		t.Errorf("Expected exactly 3 attempts, got %d", attempts)
+	}
+	if !errors.Is(receivedErr, expectedErr) {
+		t.Errorf("Expected error %v, got %v", expectedErr, receivedErr)
+	}
+}
+
+// TestWithRetryRetryIfStopsEarly tests that RetryIf returning false stops
+// retrying immediately
+func TestWithRetryRetryIfStopsEarly(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	var attempts int32
+	expectedErr := errors.New("not retryable")
+
+	f := func() (any, error) {
+		atomic.AddInt32(&attempts, 1)
+		return nil, expectedErr
+	}
+
+	New(f).WithRetry(RetryPolicy{
+		MaxAttempts:     5,
+		InitialInterval: time.Millisecond,
+		Multiplier:      2,
+		RetryIf:         func(err error) bool { return false },
+	}).WithErrorHandler(func(err error) {
+		wg.Done()
+	}).Start()
+
+	wg.Wait()
+
+	if atomic.LoadInt32(&attempts) != 1 {
+		t.Errorf("Expected exactly 1 attempt, got %d", attempts)
+	}
+}
+
+// TestWithRetryRecoversPanicPerAttempt tests that a panicking attempt is
+// retried like a regular error rather than crashing the worker
+func TestWithRetryRecoversPanicPerAttempt(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	var attempts int32
+	f := func() (any, error) {
+		n := atomic.AddInt32(&attempts, 1)
+		if n < 2 {
+			panic("flaky")
+		}
+		return "recovered", nil
+	}
+
+	var receivedResult any
+	New(f).WithRetry(RetryPolicy{
+		MaxAttempts:     3,
+		InitialInterval: time.Millisecond,
+		Multiplier:      2,
+	}).WithResultHandler(func(result any) {
+		receivedResult = result
+		wg.Done()
+	}).Start()
+
+	wg.Wait()
+
+	if receivedResult != "recovered" {
+		t.Errorf("Expected result %q, got %v", "recovered", receivedResult)
+	}
+}
+
+// TestWithRetryCtxCancelledMidAttempt tests that WithRetry on a FuncCtx task
+// reports ctx.Err() as soon as the context is cancelled, even while an
+// attempt that ignores cancellation is still in flight, matching the
+// behavior of a retry-less NewCtx task.
+func TestWithRetryCtxCancelledMidAttempt(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	var receivedErr error
+
+	f := func(ctx context.Context) (any, error) {
+		close(started)
+		time.Sleep(300 * time.Millisecond)
+		return nil, nil
+	}
+
+	start := time.Now()
+	NewCtx(f).WithContext(ctx).WithRetry(RetryPolicy{
+		MaxAttempts:     5,
+		InitialInterval: time.Millisecond,
+		Multiplier:      2,
+	}).WithErrorHandler(func(err error) {
+		receivedErr = err
+		wg.Done()
+	}).Start()
+
+	<-started
+	cancel()
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	if !errors.Is(receivedErr, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", receivedErr)
+	}
+	if elapsed >= 300*time.Millisecond {
+		t.Errorf("Expected cancellation to be reported before the attempt finished, took %v", elapsed)
+	}
+}
+
+// TestWithRetryCtxGoexitDoesNotHang tests that a FuncCtx attempt exiting via
+// runtime.Goexit is reported as a *PanicError wrapping ErrGoexit instead of
+// hanging invokeOnce's select forever.
+func TestWithRetryCtxGoexitDoesNotHang(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	var receivedErr error
+
+	f := func(ctx context.Context) (any, error) {
+		runtime.Goexit()
+		return nil, nil
+	}
+
+	NewCtx(f).WithRetry(RetryPolicy{
+		MaxAttempts:     1,
+		InitialInterval: time.Millisecond,
+		Multiplier:      2,
+	}).WithErrorHandler(func(err error) {
+		receivedErr = err
+		wg.Done()
+	}).Start()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the error handler to be called instead of hanging")
+	}
+
+	if !errors.Is(receivedErr, ErrGoexit) {
+		t.Errorf("Expected ErrGoexit, got %v", receivedErr)
+	}
+}
+
+// TestBackoffInterval tests that the computed interval respects MaxInterval
+func TestBackoffInterval(t *testing.T) {
+	policy := RetryPolicy{
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     150 * time.Millisecond,
+		Multiplier:      2,
+	}
+
+	if got := backoffInterval(policy, 3); got != 150*time.Millisecond {
+		t.Errorf("Expected interval capped at 150ms, got %v", got)
+	}
+}
@@ -0,0 +1,67 @@
+package goro
+
+// TypedFunc is a generic function type that can be executed in a
+// goroutine. It returns a result of type T and an error if the execution
+// fails.
+type TypedFunc[T any] func() (T, error)
+
+// TypedResultHandler is a generic function type that handles results of
+// type T, removing the need for callers to type-assert the result
+// themselves.
+type TypedResultHandler[T any] func(T)
+
+// TypedGoro represents a generic goroutine execution with error and
+// typed-result handling. It wraps a Goro internally and reuses the same
+// panic-recovery machinery.
+type TypedGoro[T any] struct {
+	fn            TypedFunc[T]
+	errHandler    ErrorHandler
+	resultHandler TypedResultHandler[T]
+}
+
+// NewTyped creates a new TypedGoro instance with the provided function.
+func NewTyped[T any](f TypedFunc[T]) *TypedGoro[T] {
+	return &TypedGoro[T]{
+		fn:         f,
+		errHandler: defaultErrorHandler,
+	}
+}
+
+// WithErrorHandler sets a custom error handler for the TypedGoro instance.
+func (g *TypedGoro[T]) WithErrorHandler(handler ErrorHandler) *TypedGoro[T] {
+	g.errHandler = handler
+	return g
+}
+
+// WithResultHandler sets a custom typed result handler for the TypedGoro
+// instance.
+func (g *TypedGoro[T]) WithResultHandler(handler TypedResultHandler[T]) *TypedGoro[T] {
+	g.resultHandler = handler
+	return g
+}
+
+// Start executes the function in a goroutine with the configured handlers.
+func (g *TypedGoro[T]) Start() {
+	New(func() (any, error) {
+		return g.fn()
+	}).WithErrorHandler(g.errHandler).WithResultHandler(func(result any) {
+		if g.resultHandler != nil {
+			g.resultHandler(result.(T))
+		}
+	}).Start()
+}
+
+// GoTyped executes the provided typed function in a goroutine and handles
+// any panics. If a panic occurs, it will be recovered and logged.
+func GoTyped[T any](f TypedFunc[T]) {
+	NewTyped(f).Start()
+}
+
+// GoTypedWithResultHandler executes the provided typed function in a
+// goroutine and handles any panics. If the function executes successfully,
+// the result will be passed to the provided typed result handler function.
+// If an error occurs, it will be passed to the provided error handler
+// function.
+func GoTypedWithResultHandler[T any](f TypedFunc[T], resultHandler TypedResultHandler[T], errHandler ErrorHandler) {
+	NewTyped(f).WithResultHandler(resultHandler).WithErrorHandler(errHandler).Start()
+}
@@ -0,0 +1,131 @@
+package goro
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+// TestNewTyped tests the NewTyped function
+func TestNewTyped(t *testing.T) {
+	f := func() (string, error) { return "", nil }
+	g := NewTyped(f)
+
+	if g == nil {
+		t.Fatal("NewTyped returned nil")
+	}
+	if g.fn == nil {
+		t.Error("NewTyped did not set fn")
+	}
+	if g.errHandler == nil {
+		t.Error("NewTyped did not set default error handler")
+	}
+}
+
+// TestTypedGoroStartWithResult tests that a typed result reaches the typed
+// result handler without a type assertion
+func TestTypedGoroStartWithResult(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	expectedResult := 42
+	var receivedResult int
+
+	resultHandler := func(result int) {
+		receivedResult = result
+		wg.Done()
+	}
+
+	f := func() (int, error) {
+		return expectedResult, nil
+	}
+
+	NewTyped(f).WithResultHandler(resultHandler).Start()
+
+	wg.Wait()
+
+	if receivedResult != expectedResult {
+		t.Errorf("Expected result %d, got %d", expectedResult, receivedResult)
+	}
+}
+
+// TestTypedGoroStartWithError tests error handling in TypedGoro
+func TestTypedGoroStartWithError(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	expectedErr := errors.New("typed task error")
+	var receivedErr error
+
+	errHandler := func(err error) {
+		receivedErr = err
+		wg.Done()
+	}
+
+	resultHandler := func(result string) {
+		t.Error("Result handler should not be called when error occurs")
+	}
+
+	f := func() (string, error) {
+		return "", expectedErr
+	}
+
+	NewTyped(f).WithResultHandler(resultHandler).WithErrorHandler(errHandler).Start()
+
+	wg.Wait()
+
+	if !errors.Is(receivedErr, expectedErr) {
+		t.Errorf("Expected error %v, got %v", expectedErr, receivedErr)
+	}
+}
+
+// TestGoTyped tests the GoTyped function
+func TestGoTyped(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	executed := false
+	f := func() (int, error) {
+		defer wg.Done()
+		executed = true
+		return 1, nil
+	}
+
+	GoTyped(f)
+
+	wg.Wait()
+
+	if !executed {
+		t.Error("Function was not executed")
+	}
+}
+
+// TestGoTypedWithResultHandler tests the GoTypedWithResultHandler function
+func TestGoTypedWithResultHandler(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	expectedResult := "typed result"
+	var receivedResult string
+
+	resultHandler := func(result string) {
+		receivedResult = result
+		wg.Done()
+	}
+
+	errHandler := func(err error) {
+		t.Error("Error handler should not be called when no error occurs")
+	}
+
+	f := func() (string, error) {
+		return expectedResult, nil
+	}
+
+	GoTypedWithResultHandler(f, resultHandler, errHandler)
+
+	wg.Wait()
+
+	if receivedResult != expectedResult {
+		t.Errorf("Expected result %s, got %s", expectedResult, receivedResult)
+	}
+}